@@ -0,0 +1,40 @@
+// Package cmd provides the flag-parsing glue shared by the gomarkdoc CLI:
+// selecting a registered output format, including which man(7) section a
+// --format=man run targets, and dispatching the `render` subcommand that
+// turns generated AsciiDoc into other backends via asciidoctor.
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/princjef/gomarkdoc/format"
+)
+
+// BindFormatFlags registers the --format and -section flags on fs and
+// returns a function that resolves them to a configured format.Format once
+// fs has been parsed. --format selects among the names registered with
+// format.Register (see format.List); -section configures the man(7)
+// section used when --format=man and is ignored for every other format.
+func BindFormatFlags(fs *flag.FlagSet) func() (format.Format, error) {
+	formatName := fs.String(
+		"format",
+		"asciidoc",
+		fmt.Sprintf("output format to generate. one of: %s", strings.Join(format.List(), ", ")),
+	)
+	section := fs.Int("section", 3, "man(7) section to generate into when --format=man")
+
+	return func() (format.Format, error) {
+		f, err := format.Get(*formatName)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := f.(*format.Man); ok {
+			f = format.NewMan(format.WithSection(*section))
+		}
+
+		return f, nil
+	}
+}
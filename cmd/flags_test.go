@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"flag"
+	"testing"
+)
+
+func TestBindFormatFlagsSelectsManSection(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	resolve := BindFormatFlags(fs)
+
+	if err := fs.Parse([]string{"--format", "man", "--section", "7"}); err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	f, err := resolve()
+	if err != nil {
+		t.Fatalf("resolve() returned error: %v", err)
+	}
+
+	header, err := f.Header(1, "gomarkdoc")
+	if err != nil {
+		t.Fatalf("Header returned error: %v", err)
+	}
+
+	want := ".TH GOMARKDOC 7\n"
+	if header != want {
+		t.Errorf("Header() = %q, want %q", header, want)
+	}
+}
+
+func TestBindFormatFlagsDefaultsToRegisteredFormat(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	resolve := BindFormatFlags(fs)
+
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if _, err := resolve(); err != nil {
+		t.Errorf("resolve() with --format unset returned error: %v, want the default to be a registered format", err)
+	}
+}
+
+func TestBindFormatFlagsUnknownFormat(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	resolve := BindFormatFlags(fs)
+
+	if err := fs.Parse([]string{"--format", "does-not-exist"}); err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if _, err := resolve(); err == nil {
+		t.Error("resolve() expected error for unknown format, got nil")
+	}
+}
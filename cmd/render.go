@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"os/exec"
+)
+
+// renderBackends maps the `render` subcommand's backend argument to the
+// asciidoctor -b value that produces it.
+var renderBackends = map[string]string{
+	"man":  "manpage",
+	"html": "html5",
+	"pdf":  "pdf",
+}
+
+// Render shells out to asciidoctor, converting the AsciiDoc source read
+// from src into the requested backend ("man", "html" or "pdf") and writing
+// the result to dst. It treats gomarkdoc's generated AsciiDoc as the
+// canonical intermediate representation, so it can be run standalone
+// against any --format=asciidoc output without re-invoking the extractor.
+func Render(backend, src, dst string) error {
+	asciidoctorBackend, ok := renderBackends[backend]
+	if !ok {
+		return fmt.Errorf("cmd: unsupported render backend %q (want one of man, html, pdf)", backend)
+	}
+
+	return exec.Command("asciidoctor", "-b", asciidoctorBackend, "-o", dst, src).Run()
+}
+
+// ExecuteRender parses the arguments following the `render` subcommand
+// (`gomarkdoc render <man|html|pdf> <src.adoc> <dst>`) and runs Render with
+// them.
+func ExecuteRender(args []string) error {
+	fs := flag.NewFlagSet("gomarkdoc render", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rest := fs.Args()
+	if len(rest) != 3 {
+		return fmt.Errorf("usage: gomarkdoc render <man|html|pdf> <src.adoc> <dst>")
+	}
+
+	return Render(rest[0], rest[1], rest[2])
+}
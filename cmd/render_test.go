@@ -0,0 +1,15 @@
+package cmd
+
+import "testing"
+
+func TestRenderUnsupportedBackend(t *testing.T) {
+	if err := Render("rst", "in.adoc", "out.rst"); err == nil {
+		t.Error("Render() expected error for unsupported backend, got nil")
+	}
+}
+
+func TestExecuteRenderRequiresThreeArgs(t *testing.T) {
+	if err := ExecuteRender([]string{"man", "in.adoc"}); err == nil {
+		t.Error("ExecuteRender() expected error for missing destination argument, got nil")
+	}
+}
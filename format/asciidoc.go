@@ -3,13 +3,62 @@ package format
 import (
 	"errors"
 	"fmt"
+	"go/doc/comment"
+	"net/url"
 	"strings"
 
 	"github.com/princjef/gomarkdoc/lang"
 )
 
+// defaultLineWidth is the line width used to wrap Asciidoc paragraphs when
+// no WithLineWidth option is provided.
+const defaultLineWidth = 80
+
 // Asciidoc provides a Format which is compatible asciidoc format specification.
-type Asciidoc struct{}
+type Asciidoc struct {
+	lineWidth int
+	baseURL   *url.URL
+}
+
+var _ Format = (*Asciidoc)(nil)
+
+func init() {
+	Register("asciidoc", func() Format { return NewAsciidoc() })
+}
+
+// AsciidocOption configures an Asciidoc format at construction time.
+type AsciidocOption func(*Asciidoc)
+
+// WithLineWidth sets the target column width that paragraphs are wrapped to
+// on word boundaries. Code blocks are never wrapped. A width of 0 or less
+// disables wrapping entirely.
+func WithLineWidth(width int) AsciidocOption {
+	return func(f *Asciidoc) {
+		f.lineWidth = width
+	}
+}
+
+// WithBaseURL sets the base URL that relative href values passed to Link
+// (and the out-of-scope URLs that doc links resolve to) are resolved against
+// before being emitted, so that generated docs embedded at a different
+// location (e.g. a wiki) don't produce broken relative links. CodeHref always
+// returns the empty string, so the base URL is not applied to code links.
+func WithBaseURL(base *url.URL) AsciidocOption {
+	return func(f *Asciidoc) {
+		f.baseURL = base
+	}
+}
+
+// NewAsciidoc initializes a new Asciidoc format, applying any options
+// provided. The default line width is 80 columns and there is no base URL.
+func NewAsciidoc(opts ...AsciidocOption) *Asciidoc {
+	f := &Asciidoc{lineWidth: defaultLineWidth}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
 
 // Bold converts the provided text to bold
 func (f *Asciidoc) Bold(text string) (string, error) {
@@ -20,6 +69,15 @@ func (f *Asciidoc) Bold(text string) (string, error) {
 	return fmt.Sprintf("*%s*", text), nil
 }
 
+// Italic converts the provided text to italics
+func (f *Asciidoc) Italic(text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf("_%s_", text), nil
+}
+
 // CodeBlock wraps the provided code as a code block and tags it with the
 // provided language (or no language if the empty string is provided).
 func (f *Asciidoc) CodeBlock(language, code string) (string, error) {
@@ -30,7 +88,7 @@ func (f *Asciidoc) CodeBlock(language, code string) (string, error) {
 	if "" == language {
 		language = "go"
 	}
-	return fmt.Sprintf(`[source,%s]\n----\n%s\n----`, language, code), nil
+	return fmt.Sprintf("[source,%s]\n----\n%s\n----\n", language, code), nil
 }
 
 // Header converts the provided text into a header of the provided level. The
@@ -56,7 +114,9 @@ func (f *Asciidoc) CodeHref(loc lang.Location) (string, error) {
 	return "", nil
 }
 
-// Link generates a link with the given text and href values.
+// Link generates a link with the given text and href values. A relative
+// href is resolved against BaseURL, if one was configured via
+// WithBaseURL, before being emitted.
 func (f *Asciidoc) Link(text, href string) (string, error) {
 	if text == "" {
 		return "", nil
@@ -66,7 +126,24 @@ func (f *Asciidoc) Link(text, href string) (string, error) {
 		return text, nil
 	}
 
-	return fmt.Sprintf("%s[%s]", href, text), nil
+	return fmt.Sprintf("%s[%s]", f.resolveHref(href), text), nil
+}
+
+// resolveHref resolves href against f.baseURL when it is relative and a
+// base URL has been configured. Absolute hrefs and hrefs that fail to parse
+// as a URL are returned unchanged; an in-page anchor such as "#name" parses
+// as a relative reference and is resolved against the base URL too.
+func (f *Asciidoc) resolveHref(href string) string {
+	if f.baseURL == nil {
+		return href
+	}
+
+	ref, err := url.Parse(href)
+	if err != nil || ref.IsAbs() {
+		return href
+	}
+
+	return f.baseURL.ResolveReference(ref).String()
 }
 
 // ListEntry generates an unordered list entry with the provided text at the
@@ -77,7 +154,21 @@ func (f *Asciidoc) ListEntry(depth int, text string) (string, error) {
 		return "", nil
 	}
 
-	prefix := strings.Repeat("**", depth)
+	prefix := strings.Repeat("*", depth+1)
+	return fmt.Sprintf("%s %s\n", prefix, text), nil
+}
+
+// orderedListEntry generates a numbered list entry with the provided text
+// at the provided zero-indexed depth, mirroring ListEntry but using
+// Asciidoc's "." numbering marker instead of "*". It is used for doc
+// comment lists whose items carry a comment.ListItem.Number, since the
+// Format interface has no ordered-list equivalent of ListEntry.
+func (f *Asciidoc) orderedListEntry(depth int, text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	prefix := strings.Repeat(".", depth+1)
 	return fmt.Sprintf("%s %s\n", prefix, text), nil
 }
 
@@ -101,7 +192,11 @@ func (f *Asciidoc) Accordion(title, body string) (string, error) {
 //
 //	accordion := format.AccordionHeader("Accordion Title") + "Accordion Body" + format.AccordionTerminator()
 func (f *Asciidoc) AccordionHeader(title string) (string, error) {
-	return fmt.Sprintf(".%s\nn[%%collapsible]\n====\n", title), nil
+	if "" == title {
+		title = "Description"
+	}
+
+	return fmt.Sprintf(".%s\n[%%collapsible]\n====\n", title), nil
 }
 
 // AccordionTerminator generates the code necessary to terminate an accordion
@@ -111,9 +206,11 @@ func (f *Asciidoc) AccordionTerminator() (string, error) {
 	return "\n====\n", nil
 }
 
-// Paragraph formats a paragraph with the provided text as the contents.
+// Paragraph formats a paragraph with the provided text as the contents,
+// wrapping it on word boundaries to LineWidth columns (configured via
+// WithLineWidth, defaulting to 80).
 func (f *Asciidoc) Paragraph(text string) (string, error) {
-	return fmt.Sprintf("%s\n\n", text), nil
+	return fmt.Sprintf("%s\n\n", wrapText(text, f.lineWidth)), nil
 }
 
 // Escape escapes special markdown characters from the provided text.
@@ -143,6 +240,159 @@ func (f *Asciidoc) header(level int, text string) (string, error) {
 	}
 }
 
+// RenderComment renders a parsed Go doc comment into Asciidoc. Headings
+// (introduced by a leading `#` in the doc comment source) become nested
+// sections anchored the same way as Header/RawHeader, lists become top-level
+// `*` bullet items (`.` items for numbered lists), code blocks become
+// `[source,go]` listings, and doc links (`[pkg.Sym]` / `[Text][pkg.Sym]`) are
+// resolved via resolver: symbols that resolve within the generated output
+// become `xref:` links, while anything else becomes a `link:` to its
+// pkg.go.dev URL. When resolver is nil or returns an empty ref, the doc
+// link's text is emitted without a link.
+func (f *Asciidoc) RenderComment(doc *comment.Doc, resolver lang.SymbolResolver) (string, error) {
+	if doc == nil {
+		return "", nil
+	}
+
+	var b strings.Builder
+	for _, block := range doc.Content {
+		rendered, err := f.renderCommentBlock(block, resolver, 0)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(rendered)
+	}
+
+	return b.String(), nil
+}
+
+func (f *Asciidoc) renderCommentBlock(block comment.Block, resolver lang.SymbolResolver, depth int) (string, error) {
+	switch b := block.(type) {
+	case *comment.Heading:
+		text, err := f.renderCommentText(b.Text, resolver)
+		if err != nil {
+			return "", err
+		}
+
+		// Doc comment headings start at level 2, reserving level 1 for the
+		// enclosing declaration's own header.
+		return f.header(2, text)
+
+	case *comment.Paragraph:
+		text, err := f.renderCommentText(b.Text, resolver)
+		if err != nil {
+			return "", err
+		}
+
+		return f.Paragraph(text)
+
+	case *comment.Code:
+		return f.CodeBlock("go", strings.TrimRight(b.Text, "\n"))
+
+	case *comment.List:
+		var lb strings.Builder
+		for _, item := range b.Items {
+			var ib strings.Builder
+			for _, content := range item.Content {
+				rendered, err := f.renderCommentBlock(content, resolver, depth+1)
+				if err != nil {
+					return "", err
+				}
+
+				ib.WriteString(rendered)
+			}
+
+			text := strings.TrimSpace(ib.String())
+
+			entryFn := f.ListEntry
+			if item.Number != "" {
+				entryFn = f.orderedListEntry
+			}
+
+			entry, err := entryFn(depth, text)
+			if err != nil {
+				return "", err
+			}
+
+			lb.WriteString(entry)
+		}
+
+		return lb.String(), nil
+
+	default:
+		return "", nil
+	}
+}
+
+func (f *Asciidoc) renderCommentText(text []comment.Text, resolver lang.SymbolResolver) (string, error) {
+	var b strings.Builder
+	for _, elem := range text {
+		switch t := elem.(type) {
+		case comment.Plain:
+			b.WriteString(escape(string(t)))
+		case comment.Italic:
+			italic, err := f.Italic(escape(string(t)))
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(italic)
+		case *comment.DocLink:
+			link, err := f.docLink(t, resolver)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(link)
+		case *comment.Link:
+			text, err := f.renderCommentText(t.Text, resolver)
+			if err != nil {
+				return "", err
+			}
+
+			link, err := f.Link(text, t.URL)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(link)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// docLink resolves a single [pkg.Sym] / [Text][pkg.Sym] doc link using
+// resolver, preferring a same-document xref when the symbol resolves inside
+// the generated output and falling back to an explicit `link:` to its
+// pkg.go.dev URL otherwise.
+func (f *Asciidoc) docLink(link *comment.DocLink, resolver lang.SymbolResolver) (string, error) {
+	text, err := f.renderCommentText(link.Text, resolver)
+	if err != nil {
+		return "", err
+	}
+
+	if text == "" {
+		text = escape(link.Name)
+	}
+
+	if resolver == nil {
+		return text, nil
+	}
+
+	ref, ok := resolver.Resolve(link.ImportPath, link.Name)
+	if ref == "" {
+		return text, nil
+	}
+
+	if ok {
+		return fmt.Sprintf("xref:%s[%s]", ref, text), nil
+	}
+
+	return fmt.Sprintf("link:%s[%s]", f.resolveHref(ref), text), nil
+}
+
 func (f *Asciidoc) genref(text string) string {
 	result := plainText(text)
 	result = strings.ToLower(result)
@@ -0,0 +1,86 @@
+// Package asciidoc provides a minimal reader for the AsciiDoc dialect
+// produced by format.Asciidoc, so that it can be treated as the canonical
+// intermediate representation for a gomarkdoc run and re-emitted as other
+// output formats (e.g. roff via format.Man) without re-running the
+// extractor against the original Go source.
+//
+// The reader only recognizes the specific subset of AsciiDoc constructs
+// that format.Asciidoc emits (headings, code listings, collapsibles,
+// bullet/numbered lists, and xref:/link: inline references); it is not a
+// general-purpose AsciiDoc parser.
+package asciidoc
+
+// Document is the root of a parsed AsciiDoc document: a flat sequence of
+// top-level blocks in source order.
+type Document struct {
+	Blocks []Node
+}
+
+// Node is implemented by every block and inline element the reader
+// produces.
+type Node interface{}
+
+// Heading is a `[[anchor]]` + `=`…`======` pair, carrying the section level
+// (1-6, matching the number of `=` characters) and its anchor name.
+type Heading struct {
+	Level  int
+	Anchor string
+	Text   string
+}
+
+// Paragraph is a run of body text terminated by a blank line.
+type Paragraph struct {
+	Inlines []Inline
+}
+
+// CodeBlock is a `[source,lang]` / `----` … `----` listing.
+type CodeBlock struct {
+	Language string
+	Code     string
+}
+
+// Collapsible is a `.title` / `[%collapsible]` / `====` … `====` block.
+type Collapsible struct {
+	Title string
+	Body  []Node
+}
+
+// List is a run of consecutive bullet (`*`, `**`, …) or numbered (`.`,
+// `..`, …) list entries, as produced by Asciidoc.ListEntry and its
+// unexported ordered-list counterpart.
+type List struct {
+	Items []*ListItem
+}
+
+// ListItem is a single entry in a List. Ordered is true for a `.`-marker
+// (numbered) entry and false for a `*`-marker (bullet) entry. Depth is the
+// entry's zero-indexed nesting level, encoded by how many times its marker
+// character repeats (matching the convention ListEntry/orderedListEntry
+// use when emitting it). Since Asciidoc's ordered marker is always a bare
+// `.` (asciidoctor auto-numbers it), the original doc comment's item
+// number is not recoverable from the source and is not represented here.
+type ListItem struct {
+	Ordered bool
+	Depth   int
+	Inlines []Inline
+}
+
+// Inline is implemented by the inline elements that can appear within a
+// Paragraph or ListItem.
+type Inline interface{}
+
+// Text is a run of plain, unlinked text.
+type Text string
+
+// Xref is an `xref:anchor[text]` reference to a heading anchor within the
+// same document.
+type Xref struct {
+	Anchor string
+	Text   string
+}
+
+// Link is a `link:href[text]` reference to an external URL.
+type Link struct {
+	Href string
+	Text string
+}
@@ -0,0 +1,206 @@
+package asciidoc
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	anchorRegex           = regexp.MustCompile(`^\[\[(.+)\]\]$`)
+	headingRegex          = regexp.MustCompile(`^(=+)\s+(.*)$`)
+	sourceRegex           = regexp.MustCompile(`^\[source,(.*)\]$`)
+	collapsibleTitleRegex = regexp.MustCompile(`^\.(.+)$`)
+	bulletItemRegex       = regexp.MustCompile(`^(\*+)\s+(.*)$`)
+	orderedItemRegex      = regexp.MustCompile(`^(\.+)\s+(.*)$`)
+	inlineRegex           = regexp.MustCompile(`(xref|link):([^\[\s]+)\[([^\]]*)\]`)
+)
+
+// Parse reads an AsciiDoc document in the dialect produced by
+// format.Asciidoc from r and returns its parsed representation. It
+// recognizes `[[anchor]]` + `=`…`======` headings, `[source,lang]` /
+// `----`…`----` code listings, `.title` / `[%collapsible]` / `====`…`====`
+// collapsibles, `*`…`**`… / `.`…`..`… list entries, and `xref:`/`link:`
+// inline references; any other line becomes part of an ordinary paragraph.
+func Parse(r io.Reader) (*Document, error) {
+	scanner := bufio.NewScanner(r)
+
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	doc := &Document{}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		switch {
+		case line == "":
+			i++
+
+		case anchorRegex.MatchString(line):
+			anchor := anchorRegex.FindStringSubmatch(line)[1]
+			i++
+
+			if i >= len(lines) {
+				break
+			}
+
+			m := headingRegex.FindStringSubmatch(lines[i])
+			if m == nil {
+				// Not actually a heading; treat the anchor line as inert.
+				continue
+			}
+
+			doc.Blocks = append(doc.Blocks, &Heading{
+				Level:  len(m[1]),
+				Anchor: anchor,
+				Text:   m[2],
+			})
+			i++
+
+		case sourceRegex.MatchString(line):
+			lang := sourceRegex.FindStringSubmatch(line)[1]
+			i++ // consume [source,lang]
+
+			if i < len(lines) && lines[i] == "----" {
+				i++ // consume opening ----
+			}
+
+			start := i
+			for i < len(lines) && lines[i] != "----" {
+				i++
+			}
+
+			code := strings.Join(lines[start:i], "\n")
+			if i < len(lines) {
+				i++ // consume closing ----
+			}
+
+			doc.Blocks = append(doc.Blocks, &CodeBlock{Language: lang, Code: code})
+
+		case collapsibleTitleRegex.MatchString(line) && i+1 < len(lines) && lines[i+1] == "[%collapsible]":
+			title := collapsibleTitleRegex.FindStringSubmatch(line)[1]
+			i += 2 // consume .title and [%collapsible]
+
+			if i < len(lines) && lines[i] == "====" {
+				i++ // consume opening ====
+			}
+
+			start := i
+			i = findCollapsibleEnd(lines, i)
+
+			body, err := Parse(strings.NewReader(strings.Join(lines[start:i], "\n")))
+			if err != nil {
+				return nil, err
+			}
+
+			if i < len(lines) {
+				i++ // consume closing ====
+			}
+
+			doc.Blocks = append(doc.Blocks, &Collapsible{Title: title, Body: body.Blocks})
+
+		case bulletItemRegex.MatchString(line) || orderedItemRegex.MatchString(line):
+			var items []*ListItem
+
+			for i < len(lines) {
+				if m := bulletItemRegex.FindStringSubmatch(lines[i]); m != nil {
+					items = append(items, &ListItem{Depth: len(m[1]) - 1, Inlines: parseInlines(m[2])})
+					i++
+					continue
+				}
+
+				if m := orderedItemRegex.FindStringSubmatch(lines[i]); m != nil {
+					items = append(items, &ListItem{Ordered: true, Depth: len(m[1]) - 1, Inlines: parseInlines(m[2])})
+					i++
+					continue
+				}
+
+				break
+			}
+
+			doc.Blocks = append(doc.Blocks, &List{Items: items})
+
+		default:
+			start := i
+			for i < len(lines) && lines[i] != "" {
+				i++
+			}
+
+			text := strings.Join(lines[start:i], " ")
+			doc.Blocks = append(doc.Blocks, &Paragraph{Inlines: parseInlines(text)})
+		}
+	}
+
+	return doc, nil
+}
+
+// findCollapsibleEnd scans forward from start (the first line of a
+// collapsible's body) and returns the index of the `====` line that closes
+// it, tracking any nested collapsibles so their own `====` fences aren't
+// mistaken for the outer block's closing delimiter.
+func findCollapsibleEnd(lines []string, start int) int {
+	depth := 1
+	pendingOpen := false
+
+	for i := start; i < len(lines); i++ {
+		switch {
+		case lines[i] == "[%collapsible]" && i > 0 && collapsibleTitleRegex.MatchString(lines[i-1]):
+			pendingOpen = true
+
+		case lines[i] == "====":
+			if pendingOpen {
+				depth++
+				pendingOpen = false
+				continue
+			}
+
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return len(lines)
+}
+
+// parseInlines splits text into a sequence of plain Text, Xref and Link
+// inline nodes.
+func parseInlines(text string) []Inline {
+	var inlines []Inline
+
+	matches := inlineRegex.FindAllStringSubmatchIndex(text, -1)
+
+	last := 0
+	for _, m := range matches {
+		if m[0] > last {
+			inlines = append(inlines, Text(text[last:m[0]]))
+		}
+
+		kind := text[m[2]:m[3]]
+		target := text[m[4]:m[5]]
+		linkText := text[m[6]:m[7]]
+
+		if kind == "xref" {
+			inlines = append(inlines, Xref{Anchor: target, Text: linkText})
+		} else {
+			inlines = append(inlines, Link{Href: target, Text: linkText})
+		}
+
+		last = m[1]
+	}
+
+	if last < len(text) {
+		inlines = append(inlines, Text(text[last:]))
+	}
+
+	return inlines
+}
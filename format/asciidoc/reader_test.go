@@ -0,0 +1,97 @@
+package asciidoc
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseRoundTripsResolvedDocLinks exercises the exact pairing that
+// format.Asciidoc.docLink now produces: an xref: for a doc link that
+// resolves within the generated output, and a link: for one that doesn't,
+// which is the only prefix inlineRegex recognizes.
+func TestParseRoundTripsResolvedDocLinks(t *testing.T) {
+	src := "See xref:my-anchor[InScope] and link:https://pkg.go.dev/foo#Bar[OutOfScope] for more."
+
+	doc, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if len(doc.Blocks) != 1 {
+		t.Fatalf("Parse() produced %d blocks, want 1", len(doc.Blocks))
+	}
+
+	p, ok := doc.Blocks[0].(*Paragraph)
+	if !ok {
+		t.Fatalf("Parse() block = %T, want *Paragraph", doc.Blocks[0])
+	}
+
+	var gotXref, gotLink bool
+	for _, inline := range p.Inlines {
+		switch in := inline.(type) {
+		case Xref:
+			if in.Anchor == "my-anchor" && in.Text == "InScope" {
+				gotXref = true
+			}
+		case Link:
+			if in.Href == "https://pkg.go.dev/foo#Bar" && in.Text == "OutOfScope" {
+				gotLink = true
+			}
+		}
+	}
+
+	if !gotXref {
+		t.Errorf("Parse() did not recover the xref: inline from %q", src)
+	}
+
+	if !gotLink {
+		t.Errorf("Parse() did not recover the link: inline from %q", src)
+	}
+}
+
+func TestParseRecognizesNestedAndOrderedLists(t *testing.T) {
+	src := "* first item\n** nested item\n* second item\n\n. step one\n. step two\n"
+
+	doc, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if len(doc.Blocks) != 2 {
+		t.Fatalf("Parse() produced %d blocks, want 2 (one bullet list, one ordered list); got %#v", len(doc.Blocks), doc.Blocks)
+	}
+
+	bullets, ok := doc.Blocks[0].(*List)
+	if !ok {
+		t.Fatalf("Parse() block[0] = %T, want *List", doc.Blocks[0])
+	}
+
+	wantBullets := []ListItem{
+		{Depth: 0, Inlines: []Inline{Text("first item")}},
+		{Depth: 1, Inlines: []Inline{Text("nested item")}},
+		{Depth: 0, Inlines: []Inline{Text("second item")}},
+	}
+	if len(bullets.Items) != len(wantBullets) {
+		t.Fatalf("bullet list has %d items, want %d: %#v", len(bullets.Items), len(wantBullets), bullets.Items)
+	}
+	for i, want := range wantBullets {
+		got := *bullets.Items[i]
+		if got.Ordered != want.Ordered || got.Depth != want.Depth || got.Inlines[0] != want.Inlines[0] {
+			t.Errorf("bullet list item %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	ordered, ok := doc.Blocks[1].(*List)
+	if !ok {
+		t.Fatalf("Parse() block[1] = %T, want *List", doc.Blocks[1])
+	}
+
+	if len(ordered.Items) != 2 {
+		t.Fatalf("ordered list has %d items, want 2: %#v", len(ordered.Items), ordered.Items)
+	}
+	for i, item := range ordered.Items {
+		if !item.Ordered {
+			t.Errorf("ordered list item %d.Ordered = false, want true", i)
+		}
+	}
+}
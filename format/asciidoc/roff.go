@@ -0,0 +1,148 @@
+package asciidoc
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/princjef/gomarkdoc/format"
+)
+
+// RenderMan re-emits a parsed Document as groff man(7) source via the
+// provided Man format, so that a single gomarkdoc Asciidoc run can also be
+// shipped as a man page without re-running the extractor against the
+// original Go source. Bullet list entries render via Man.ListEntry;
+// numbered entries are renumbered sequentially per contiguous run at a
+// given depth and render via Man.OrderedListEntry, since the source
+// numbering itself isn't preserved by the reader (see List/ListItem).
+func RenderMan(doc *Document, man *format.Man) (string, error) {
+	var b strings.Builder
+
+	for _, block := range doc.Blocks {
+		rendered, err := renderManBlock(block, man)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(rendered)
+	}
+
+	return b.String(), nil
+}
+
+func renderManBlock(block Node, man *format.Man) (string, error) {
+	switch n := block.(type) {
+	case *Heading:
+		return man.Header(n.Level, n.Text)
+
+	case *CodeBlock:
+		return man.CodeBlock(n.Language, n.Code)
+
+	case *Collapsible:
+		header, err := man.AccordionHeader(n.Title)
+		if err != nil {
+			return "", err
+		}
+
+		var body strings.Builder
+		for _, child := range n.Body {
+			rendered, err := renderManBlock(child, man)
+			if err != nil {
+				return "", err
+			}
+
+			body.WriteString(rendered)
+		}
+
+		terminator, err := man.AccordionTerminator()
+		if err != nil {
+			return "", err
+		}
+
+		return header + body.String() + terminator, nil
+
+	case *Paragraph:
+		text, err := renderManInlines(n.Inlines, man)
+		if err != nil {
+			return "", err
+		}
+
+		return man.Paragraph(text)
+
+	case *List:
+		return renderManList(n, man)
+
+	default:
+		return "", nil
+	}
+}
+
+// renderManList re-emits a parsed List, numbering ordered entries
+// sequentially within each contiguous run of ordered items at the same
+// depth (the original doc comment item numbers aren't recoverable, since
+// Asciidoc's ordered marker is a bare `.` that asciidoctor auto-numbers).
+func renderManList(n *List, man *format.Man) (string, error) {
+	var b strings.Builder
+	number := map[int]int{}
+
+	for i, item := range n.Items {
+		text, err := renderManInlines(item.Inlines, man)
+		if err != nil {
+			return "", err
+		}
+
+		if !item.Ordered {
+			delete(number, item.Depth)
+
+			entry, err := man.ListEntry(item.Depth, text)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(entry)
+			continue
+		}
+
+		if i == 0 || !n.Items[i-1].Ordered || n.Items[i-1].Depth != item.Depth {
+			number[item.Depth] = 0
+		}
+		number[item.Depth]++
+
+		entry, err := man.OrderedListEntry(strconv.Itoa(number[item.Depth]), item.Depth, text)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(entry)
+	}
+
+	return b.String(), nil
+}
+
+func renderManInlines(inlines []Inline, man *format.Man) (string, error) {
+	var b strings.Builder
+
+	for _, inline := range inlines {
+		switch in := inline.(type) {
+		case Text:
+			b.WriteString(string(in))
+
+		case Xref:
+			link, err := man.LocalHref(in.Text)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(link)
+
+		case Link:
+			link, err := man.Link(in.Text, in.Href)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(link)
+		}
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,42 @@
+package asciidoc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/princjef/gomarkdoc/format"
+)
+
+func TestRenderManRendersHeadingsCodeAndLists(t *testing.T) {
+	doc := &Document{
+		Blocks: []Node{
+			&Heading{Level: 2, Anchor: "intro", Text: "Intro"},
+			&Paragraph{Inlines: []Inline{Text("hello "), Link{Href: "https://example.com", Text: "world"}}},
+			&CodeBlock{Language: "go", Code: `fmt.Println("hi")`},
+			&List{Items: []*ListItem{
+				{Depth: 0, Inlines: []Inline{Text("bullet one")}},
+				{Depth: 0, Ordered: true, Inlines: []Inline{Text("step one")}},
+				{Depth: 0, Ordered: true, Inlines: []Inline{Text("step two")}},
+			}},
+		},
+	}
+
+	got, err := RenderMan(doc, format.NewMan())
+	if err != nil {
+		t.Fatalf("RenderMan returned error: %v", err)
+	}
+
+	for _, want := range []string{
+		".SH INTRO",
+		"hello",
+		".nf",
+		"fmt.Println",
+		`.IP "\(bu" 2`,
+		`.IP "1." 4`,
+		`.IP "2." 4`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("RenderMan() = %q, want it to contain %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package format
+
+import (
+	"go/doc/comment"
+	"strings"
+	"testing"
+
+	"github.com/princjef/gomarkdoc/lang"
+)
+
+type stubResolver struct {
+	ref string
+	ok  bool
+}
+
+func (r stubResolver) Resolve(pkg, sym string) (string, bool) {
+	return r.ref, r.ok
+}
+
+func TestAsciidocRenderCommentTextLink(t *testing.T) {
+	f := NewAsciidoc()
+
+	text := []comment.Text{
+		&comment.Link{
+			Text: []comment.Text{comment.Plain("see "), comment.Italic("here")},
+			URL:  "https://example.com",
+		},
+	}
+
+	got, err := f.renderCommentText(text, nil)
+	if err != nil {
+		t.Fatalf("renderCommentText returned error: %v", err)
+	}
+
+	want := "https://example.com[see _here_]"
+	if got != want {
+		t.Errorf("renderCommentText() = %q, want %q", got, want)
+	}
+}
+
+func TestAsciidocDocLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolver lang.SymbolResolver
+		want     string
+	}{
+		{
+			name:     "no resolver",
+			resolver: nil,
+			want:     "Sym",
+		},
+		{
+			name:     "resolved in scope",
+			resolver: stubResolver{ref: "pkg-sym", ok: true},
+			want:     "xref:pkg-sym[Sym]",
+		},
+		{
+			name:     "resolved out of scope",
+			resolver: stubResolver{ref: "https://pkg.go.dev/pkg#Sym", ok: false},
+			want:     "link:https://pkg.go.dev/pkg#Sym[Sym]",
+		},
+	}
+
+	f := NewAsciidoc()
+	link := &comment.DocLink{Name: "Sym"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := f.docLink(link, tt.resolver)
+			if err != nil {
+				t.Fatalf("docLink returned error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("docLink() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsciidocRenderCommentBlockList(t *testing.T) {
+	f := NewAsciidoc()
+
+	list := &comment.List{
+		Items: []*comment.ListItem{
+			{
+				Number:  "1",
+				Content: []comment.Block{&comment.Paragraph{Text: []comment.Text{comment.Plain("first")}}},
+			},
+			{
+				Content: []comment.Block{&comment.Paragraph{Text: []comment.Text{comment.Plain("second")}}},
+			},
+		},
+	}
+
+	got, err := f.renderCommentBlock(list, nil, 0)
+	if err != nil {
+		t.Fatalf("renderCommentBlock returned error: %v", err)
+	}
+
+	if !strings.Contains(got, ". first") {
+		t.Errorf("renderCommentBlock() = %q, want ordered marker for numbered item", got)
+	}
+
+	if !strings.Contains(got, "* second") {
+		t.Errorf("renderCommentBlock() = %q, want bullet marker for unnumbered item", got)
+	}
+}
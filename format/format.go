@@ -0,0 +1,74 @@
+package format
+
+import (
+	"go/doc/comment"
+
+	"github.com/princjef/gomarkdoc/lang"
+)
+
+// Format is the interface used to represent code generators for the
+// gomarkdoc tool. Anything implementing this interface can be plugged in
+// and used as the output format for documentation.
+type Format interface {
+	// Bold converts the provided text to bold
+	Bold(text string) (string, error)
+
+	// Italic converts the provided text to italics
+	Italic(text string) (string, error)
+
+	// CodeBlock wraps the provided code as a code block and tags it with the
+	// provided language (or no language if the empty string is provided).
+	CodeBlock(language, code string) (string, error)
+
+	// Header converts the provided text into a header of the provided level.
+	// The level is expected to be at least 1.
+	Header(level int, text string) (string, error)
+
+	// RawHeader converts the provided text into a header of the provided
+	// level without escaping the header text. The level is expected to be at
+	// least 1.
+	RawHeader(level int, text string) (string, error)
+
+	// LocalHref generates an href for navigating to a header with the given
+	// headerText located within the same document as the href itself.
+	LocalHref(headerText string) (string, error)
+
+	// CodeHref generates an href for navigating to the provided code
+	// location.
+	CodeHref(loc lang.Location) (string, error)
+
+	// Link generates a link with the given text and href values.
+	Link(text, href string) (string, error)
+
+	// ListEntry generates an unordered list entry with the provided text at
+	// the provided zero-indexed depth. A depth of 0 is considered the
+	// topmost level of list.
+	ListEntry(depth int, text string) (string, error)
+
+	// Accordion generates a collapsible content. The body is not escaped so
+	// that it may contain arbitrary nested formatted content.
+	Accordion(title, body string) (string, error)
+
+	// AccordionHeader generates the header visible when an accordion is
+	// collapsed. It is expected to be used in conjunction with
+	// AccordionTerminator() when the demands of the body's rendering
+	// requires it to be generated independently.
+	AccordionHeader(title string) (string, error)
+
+	// AccordionTerminator generates the code necessary to terminate an
+	// accordion after the body. It is expected to be used in conjunction
+	// with AccordionHeader().
+	AccordionTerminator() (string, error)
+
+	// Paragraph formats a paragraph with the provided text as the contents.
+	Paragraph(text string) (string, error)
+
+	// Escape escapes special characters for the format from the provided
+	// text.
+	Escape(text string) string
+
+	// RenderComment renders a parsed Go doc comment (as produced by
+	// go/doc/comment) into the target format, resolving any doc links
+	// ([pkg.Sym] or [Text][pkg.Sym]) via the provided resolver.
+	RenderComment(doc *comment.Doc, resolver lang.SymbolResolver) (string, error)
+}
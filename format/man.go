@@ -0,0 +1,341 @@
+package format
+
+import (
+	"fmt"
+	"go/doc/comment"
+	"strings"
+
+	"github.com/princjef/gomarkdoc/lang"
+)
+
+// Man provides a Format which emits groff man(7) source, suitable for
+// installation under a standard man path (e.g. /usr/share/man/man3). It is
+// selected on the CLI via `--format=man`, with the target man section
+// configurable via `-section N` (defaulting to section 3, the section
+// reserved for library documentation).
+type Man struct {
+	section int
+}
+
+var _ Format = (*Man)(nil)
+
+func init() {
+	Register("man", func() Format { return NewMan() })
+}
+
+// ManOption configures a Man format at construction time.
+type ManOption func(*Man)
+
+// WithSection sets the man(7) section number (e.g. 1, 3, 7) used when
+// generating the `.TH` title header. It defaults to 3.
+func WithSection(section int) ManOption {
+	return func(m *Man) {
+		m.section = section
+	}
+}
+
+// NewMan initializes a new Man format, applying any options provided.
+func NewMan(opts ...ManOption) *Man {
+	m := &Man{section: 3}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Bold converts the provided text to bold
+func (f *Man) Bold(text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf(`\fB%s\fR`, text), nil
+}
+
+// Italic converts the provided text to italics
+func (f *Man) Italic(text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf(`\fI%s\fR`, text), nil
+}
+
+// CodeBlock wraps the provided code as an indented, no-fill block and tags
+// it with the provided language as a comment, since man has no concept of
+// syntax highlighting.
+func (f *Man) CodeBlock(language, code string) (string, error) {
+	if code == "" {
+		return "", nil
+	}
+
+	var b strings.Builder
+	b.WriteString(".RS\n")
+
+	if language != "" {
+		b.WriteString(fmt.Sprintf(`.\" %s`, language))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(".nf\n")
+	b.WriteString(f.Escape(code))
+	b.WriteString("\n.fi\n")
+	b.WriteString(".RE\n")
+
+	return b.String(), nil
+}
+
+// Header converts the provided text into a header of the provided level.
+// Levels 1-3 map to `.TH`, `.SH` and `.SS` respectively; anything deeper is
+// rendered as a `.SS` subsection since man only supports two levels of
+// sectioning.
+func (f *Man) Header(level int, text string) (string, error) {
+	return f.header(level, text)
+}
+
+// RawHeader converts the provided text into a header of the provided level.
+// Man has no header escaping beyond its own control characters, so this is
+// equivalent to Header.
+func (f *Man) RawHeader(level int, text string) (string, error) {
+	return f.header(level, text)
+}
+
+func (f *Man) header(level int, text string) (string, error) {
+	if level < 1 {
+		return "", fmt.Errorf("format: header level cannot be less than 1")
+	}
+
+	switch level {
+	case 1:
+		return fmt.Sprintf(".TH %s %d\n", strings.ToUpper(text), f.section), nil
+	case 2:
+		return fmt.Sprintf(".SH %s\n", strings.ToUpper(text)), nil
+	default:
+		return fmt.Sprintf(".SS %s\n", text), nil
+	}
+}
+
+// LocalHref degrades to the plain section name, since man pages have no
+// anchors to navigate to.
+func (f *Man) LocalHref(headerText string) (string, error) {
+	return headerText, nil
+}
+
+// CodeHref degrades to the empty string, since man pages cannot link back to
+// source.
+func (f *Man) CodeHref(loc lang.Location) (string, error) {
+	return "", nil
+}
+
+// Link renders text followed by the href in italics, since man has no
+// hyperlink syntax in the general case.
+func (f *Man) Link(text, href string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	if href == "" {
+		return text, nil
+	}
+
+	return fmt.Sprintf(`%s \fI%s\fR`, text, href), nil
+}
+
+// ListEntry generates a bulleted list entry at the provided zero-indexed
+// depth, opening/closing a `.RS`/`.RE` indent region for each level of
+// nesting beyond the first.
+func (f *Man) ListEntry(depth int, text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < depth; i++ {
+		b.WriteString(".RS\n")
+	}
+
+	b.WriteString(".IP \"\\(bu\" 2\n")
+	b.WriteString(text)
+	b.WriteString("\n")
+
+	for i := 0; i < depth; i++ {
+		b.WriteString(".RE\n")
+	}
+
+	return b.String(), nil
+}
+
+// OrderedListEntry generates a numbered list entry with the provided number
+// and text at the provided zero-indexed depth, mirroring ListEntry but
+// using number (e.g. "1", "2") as the item's tag instead of a bullet glyph.
+// It is exported, unlike Asciidoc's equivalent, so that
+// format/asciidoc.RenderMan can use it when re-emitting a parsed ordered
+// list.
+func (f *Man) OrderedListEntry(number string, depth int, text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < depth; i++ {
+		b.WriteString(".RS\n")
+	}
+
+	b.WriteString(fmt.Sprintf(".IP \"%s.\" 4\n", number))
+	b.WriteString(text)
+	b.WriteString("\n")
+
+	for i := 0; i < depth; i++ {
+		b.WriteString(".RE\n")
+	}
+
+	return b.String(), nil
+}
+
+// Accordion collapses to a plain `.SS` subsection, since man pages have no
+// notion of a collapsible section.
+func (f *Man) Accordion(title, body string) (string, error) {
+	header, err := f.AccordionHeader(title)
+	if err != nil {
+		return "", err
+	}
+
+	terminator, err := f.AccordionTerminator()
+	if err != nil {
+		return "", err
+	}
+
+	return header + body + terminator, nil
+}
+
+// AccordionHeader generates the subsection header standing in for an
+// accordion. See Accordion for a full description.
+func (f *Man) AccordionHeader(title string) (string, error) {
+	if title == "" {
+		title = "Description"
+	}
+
+	return fmt.Sprintf(".SS %s\n", title), nil
+}
+
+// AccordionTerminator generates the code necessary to terminate an
+// accordion-equivalent subsection after the body. Since man subsections need
+// no explicit close, this returns the empty string.
+func (f *Man) AccordionTerminator() (string, error) {
+	return "", nil
+}
+
+// Paragraph formats a paragraph with the provided text as the contents,
+// preceded by `.PP` to start a new paragraph block.
+func (f *Man) Paragraph(text string) (string, error) {
+	return fmt.Sprintf(".PP\n%s\n", text), nil
+}
+
+// Escape escapes the groff control character (`.` and `'` at the start of a
+// line, plus the backslash escape character) from the provided text.
+func (f *Man) Escape(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\\`)
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			line = `\&` + line
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// RenderComment renders a parsed Go doc comment into groff source. Man has
+// no heading levels of its own to spare, so doc comment headings render as
+// `.SS` subsections and lists/code blocks use the same constructs as
+// ListEntry/CodeBlock. resolver is not consulted: doc links and URL links
+// are flattened to their text.
+func (f *Man) RenderComment(doc *comment.Doc, resolver lang.SymbolResolver) (string, error) {
+	if doc == nil {
+		return "", nil
+	}
+
+	var b strings.Builder
+	for _, block := range doc.Content {
+		rendered, err := f.renderCommentBlock(block, 0)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(rendered)
+	}
+
+	return b.String(), nil
+}
+
+func (f *Man) renderCommentBlock(block comment.Block, depth int) (string, error) {
+	switch b := block.(type) {
+	case *comment.Heading:
+		return f.header(3, commentText(b.Text))
+
+	case *comment.Paragraph:
+		return f.Paragraph(commentText(b.Text))
+
+	case *comment.Code:
+		return f.CodeBlock("", strings.TrimRight(b.Text, "\n"))
+
+	case *comment.List:
+		var lb strings.Builder
+		for _, item := range b.Items {
+			var ib strings.Builder
+			for _, content := range item.Content {
+				rendered, err := f.renderCommentBlock(content, depth+1)
+				if err != nil {
+					return "", err
+				}
+
+				ib.WriteString(rendered)
+			}
+
+			text := strings.TrimSpace(ib.String())
+
+			var entry string
+			var err error
+			if item.Number != "" {
+				entry, err = f.OrderedListEntry(item.Number, depth, text)
+			} else {
+				entry, err = f.ListEntry(depth, text)
+			}
+			if err != nil {
+				return "", err
+			}
+
+			lb.WriteString(entry)
+		}
+
+		return lb.String(), nil
+
+	default:
+		return "", nil
+	}
+}
+
+// commentText flattens the inline elements of a doc comment text run to
+// plain text, since man has no inline link syntax worth preserving in the
+// collapsed output of a heading or paragraph.
+func commentText(text []comment.Text) string {
+	var b strings.Builder
+	for _, elem := range text {
+		switch t := elem.(type) {
+		case comment.Plain:
+			b.WriteString(string(t))
+		case comment.Italic:
+			b.WriteString(string(t))
+		case *comment.DocLink:
+			b.WriteString(commentText(t.Text))
+		case *comment.Link:
+			b.WriteString(commentText(t.Text))
+		}
+	}
+
+	return b.String()
+}
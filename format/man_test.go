@@ -0,0 +1,93 @@
+package format
+
+import (
+	"go/doc/comment"
+	"strings"
+	"testing"
+)
+
+func TestCommentTextFlattensLinks(t *testing.T) {
+	text := []comment.Text{
+		comment.Plain("see "),
+		&comment.Link{Text: []comment.Text{comment.Plain("here")}, URL: "https://example.com"},
+	}
+
+	got := commentText(text)
+	want := "see here"
+	if got != want {
+		t.Errorf("commentText() = %q, want %q", got, want)
+	}
+}
+
+func TestManCodeBlockEscapesGroffControlChars(t *testing.T) {
+	f := NewMan()
+
+	code := "re := regexp.MustCompile(`\\d+`)\n.foo()"
+
+	got, err := f.CodeBlock("go", code)
+	if err != nil {
+		t.Fatalf("CodeBlock returned error: %v", err)
+	}
+
+	if !strings.Contains(got, `\\d`) {
+		t.Errorf("CodeBlock() = %q, want escaped backslash sequence", got)
+	}
+
+	if strings.Contains(got, "\n.foo") {
+		t.Errorf("CodeBlock() = %q, want leading '.' on a code line escaped so groff doesn't treat it as a control line", got)
+	}
+
+	if !strings.Contains(got, `\&.foo`) {
+		t.Errorf("CodeBlock() = %q, want leading '.' escaped via \\&", got)
+	}
+}
+
+func TestManCodeBlockIndentsAndTagsLanguage(t *testing.T) {
+	f := NewMan()
+
+	got, err := f.CodeBlock("go", "fmt.Println(1)")
+	if err != nil {
+		t.Fatalf("CodeBlock returned error: %v", err)
+	}
+
+	if !strings.Contains(got, ".RS\n") || !strings.Contains(got, ".RE\n") {
+		t.Errorf("CodeBlock() = %q, want it wrapped in a .RS/.RE indent region", got)
+	}
+
+	if !strings.Contains(got, `.\" go`) {
+		t.Errorf("CodeBlock() = %q, want the language tagged as a comment", got)
+	}
+}
+
+func TestManRenderCommentBlockNestedOrderedList(t *testing.T) {
+	f := NewMan()
+
+	list := &comment.List{
+		Items: []*comment.ListItem{
+			{
+				Number: "1",
+				Content: []comment.Block{
+					&comment.Paragraph{Text: []comment.Text{comment.Plain("first")}},
+					&comment.List{
+						Items: []*comment.ListItem{
+							{Content: []comment.Block{&comment.Paragraph{Text: []comment.Text{comment.Plain("nested")}}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got, err := f.renderCommentBlock(list, 0)
+	if err != nil {
+		t.Fatalf("renderCommentBlock returned error: %v", err)
+	}
+
+	if !strings.Contains(got, `.IP "1." 4`) {
+		t.Errorf("renderCommentBlock() = %q, want a numbered entry for the ordered item", got)
+	}
+
+	if strings.Count(got, ".RS\n") != 1 || strings.Count(got, ".RE\n") != 1 {
+		t.Errorf("renderCommentBlock() = %q, want the nested item indented by a single .RS/.RE pair", got)
+	}
+}
@@ -0,0 +1,64 @@
+package format
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// registry holds the set of Format factories registered via Register,
+// keyed by the name passed on the CLI's --format flag.
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]func() Format{}
+)
+
+// Register adds a Format factory under the provided name, making it
+// selectable via the CLI's --format flag. Built-in formats register
+// themselves this way from an init() in their own file; third-party
+// binaries that import gomarkdoc as a library can call Register from their
+// own init() (or main) to add custom formats such as reStructuredText,
+// DocBook, or Confluence storage format before invoking the CLI.
+//
+// Register panics if name is already registered, since this always
+// indicates a programming error rather than a runtime condition.
+func Register(name string, factory func() Format) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("format: %q is already registered", name))
+	}
+
+	registry[name] = factory
+}
+
+// Get constructs a new instance of the Format registered under name. It
+// returns an error if no Format has been registered under that name.
+func Get(name string) (Format, error) {
+	registryMu.RLock()
+	factory, ok := registry[name]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("format: no format registered under name %q", name)
+	}
+
+	return factory(), nil
+}
+
+// List returns the names of all registered formats in alphabetical order,
+// suitable for display in --help output.
+func List() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
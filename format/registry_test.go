@@ -0,0 +1,43 @@
+package format
+
+import (
+	"sort"
+	"testing"
+)
+
+type fakeFormat struct{ Asciidoc }
+
+func TestRegisterGetList(t *testing.T) {
+	Register("fake-format-for-test", func() Format { return &fakeFormat{} })
+
+	f, err := Get("fake-format-for-test")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+
+	if _, ok := f.(*fakeFormat); !ok {
+		t.Errorf("Get() = %T, want *fakeFormat", f)
+	}
+
+	names := List()
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("List() = %v, want sorted names", names)
+	}
+
+	found := false
+	for _, name := range names {
+		if name == "fake-format-for-test" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("List() = %v, want it to include a registered format", names)
+	}
+}
+
+func TestGetUnregistered(t *testing.T) {
+	if _, err := Get("does-not-exist-as-a-format"); err == nil {
+		t.Error("Get() expected error for unregistered name, got nil")
+	}
+}
@@ -0,0 +1,34 @@
+package format
+
+import "regexp"
+
+// gfmWhitespaceRegex matches runs of whitespace so they can be collapsed into
+// a single separator when generating header references.
+var gfmWhitespaceRegex = regexp.MustCompile(`\s+`)
+
+// gfmRemoveRegex matches characters that are not safe to include in a header
+// reference and should be stripped entirely.
+var gfmRemoveRegex = regexp.MustCompile(`[^\w\- ]`)
+
+// plainText returns text unchanged. Escaping backslashes and other
+// formatting characters are stripped afterwards by gfmRemoveRegex when a
+// header reference is built.
+func plainText(text string) string {
+	return text
+}
+
+// escape escapes special markdown/asciidoc characters from the provided
+// text so that they are rendered literally instead of being interpreted as
+// formatting syntax.
+func escape(text string) string {
+	var b []byte
+	for _, r := range text {
+		switch r {
+		case '*', '_', '`', '<', '>', '[', ']', '#', '\\':
+			b = append(b, '\\')
+		}
+		b = append(b, string(r)...)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,103 @@
+package format
+
+import (
+	"strings"
+	"unicode"
+)
+
+// wrapText wraps text on word boundaries so that no line exceeds width
+// columns, in the same manner glamour wraps markdown for terminal output.
+// Existing whitespace (including embedded newlines) is collapsed, since
+// wrapText is only applied to paragraph-level text, never to code blocks or
+// tables. A width of 0 or less disables wrapping and returns text as-is.
+//
+// A `...[...]` span, as emitted by Link/docLink for `href[text]` and
+// `xref:anchor[text]` macros, is treated as a single unbreakable word even
+// when its bracketed text contains spaces, so that wrapping never splits
+// the macro across lines.
+func wrapText(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+
+	words := wrapWords(text)
+	if len(words) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	lineLen := 0
+
+	for i, word := range words {
+		wordLen := len([]rune(word))
+
+		if i == 0 {
+			b.WriteString(word)
+			lineLen = wordLen
+			continue
+		}
+
+		if lineLen+1+wordLen > width {
+			b.WriteString("\n")
+			b.WriteString(word)
+			lineLen = wordLen
+			continue
+		}
+
+		b.WriteString(" ")
+		b.WriteString(word)
+		lineLen += 1 + wordLen
+	}
+
+	return b.String()
+}
+
+// wrapWords splits text into the units wrapText treats as unbreakable:
+// ordinary whitespace-delimited words, except that a `[...]` bracket span
+// is consumed whole (tracking nested brackets, ignoring whitespace inside)
+// so that a multi-word link/xref macro stays attached to the text
+// preceding its opening bracket.
+func wrapWords(text string) []string {
+	runes := []rune(text)
+	n := len(runes)
+
+	var words []string
+	i := 0
+
+	for i < n {
+		for i < n && unicode.IsSpace(runes[i]) {
+			i++
+		}
+		if i >= n {
+			break
+		}
+
+		start := i
+		for i < n && !unicode.IsSpace(runes[i]) {
+			if runes[i] == '[' {
+				depth := 0
+				for i < n {
+					switch runes[i] {
+					case '[':
+						depth++
+					case ']':
+						depth--
+					}
+					i++
+
+					if depth == 0 {
+						break
+					}
+				}
+
+				continue
+			}
+
+			i++
+		}
+
+		words = append(words, string(runes[start:i]))
+	}
+
+	return words
+}
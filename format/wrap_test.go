@@ -0,0 +1,18 @@
+package format
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWrapTextKeepsBracketedLinkMacroUnbroken(t *testing.T) {
+	text := "see the xref:some-anchor[a multi word link target] for details"
+
+	got := wrapText(text, 20)
+
+	for _, line := range strings.Split(got, "\n") {
+		if strings.Contains(line, "[") && !strings.Contains(line, "]") {
+			t.Fatalf("wrapText(%q, 20) split a bracketed macro across lines: %q", text, got)
+		}
+	}
+}
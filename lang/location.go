@@ -0,0 +1,16 @@
+package lang
+
+// Location identifies a position within the source code of a package. It is
+// primarily used to generate links back to the source from its rendered
+// documentation.
+type Location struct {
+	// Filename holds the path of the file containing the location, relative
+	// to the repository root.
+	Filename string
+
+	// Line holds the one-indexed line number of the location.
+	Line int
+
+	// Col holds the one-indexed column number of the location.
+	Col int
+}
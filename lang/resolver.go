@@ -0,0 +1,15 @@
+package lang
+
+// SymbolResolver resolves a symbol referenced from a Go doc comment (e.g. the
+// `pkg.Sym` or `Sym` appearing in `[pkg.Sym]` and `[Text][pkg.Sym]` doc
+// links) to a location that a Format can render as a link.
+//
+// When the symbol resolves to a location within the documentation currently
+// being generated, Resolve returns ok as true and ref set to the header
+// reference (the same value a Format's genref-style header anchor would
+// produce). When the symbol is out of scope of the generated output, ok is
+// false and ref holds a fully-qualified URL (typically a pkg.go.dev link)
+// that a Format can emit as an external link instead.
+type SymbolResolver interface {
+	Resolve(pkg, sym string) (ref string, ok bool)
+}